@@ -0,0 +1,73 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// DeepCopy/DeepCopyInto methods for the types in this file are generated into
+// zz_generated.deepcopy.go; run `make generate` after changing these types.
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// BackupStoreProxy holds the configuration for an egress HTTP(S) proxy that the backup-restore
+// sidecar should use to reach the configured object store. It is never applied to the etcd
+// container itself.
+type BackupStoreProxy struct {
+	// HTTPProxy is the proxy to use for plain HTTP requests made by the backup-restore sidecar.
+	// +optional
+	HTTPProxy *ProxyURL `json:"httpProxy,omitempty"`
+	// HTTPSProxy is the proxy to use for HTTPS requests made by the backup-restore sidecar.
+	// +optional
+	HTTPSProxy *ProxyURL `json:"httpsProxy,omitempty"`
+	// NoProxy is a comma-separated list of additional hosts and domains that must bypass the proxy.
+	// The in-cluster peer and client service DNS names are always merged in when the env vars for
+	// the backup-restore sidecar are rendered, regardless of what is set here, so intra-cluster peer
+	// traffic can never be routed through the proxy.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+	// CABundleSecretRef refers to a Secret key holding a PEM-encoded CA bundle for a proxy that
+	// terminates TLS. When set, the bundle is mounted into the backup-restore sidecar and
+	// SSL_CERT_FILE is pointed at it.
+	// +optional
+	CABundleSecretRef *CABundleSecretReference `json:"caBundleSecretRef,omitempty"`
+}
+
+// ProxyURL is a proxy URL that can either be given as a plain value or sourced from a Secret key,
+// so that credentials embedded in a proxy URL never need to be stored in the Etcd resource itself.
+type ProxyURL struct {
+	// Value is the plain-text proxy URL, e.g. "http://proxy.example.com:3128".
+	// +optional
+	Value *string `json:"value,omitempty"`
+	// ValueFrom sources the proxy URL from a key in a Secret.
+	// +optional
+	ValueFrom *ProxyURLSource `json:"valueFrom,omitempty"`
+}
+
+// ProxyURLSource identifies a Secret key that holds a proxy URL.
+type ProxyURLSource struct {
+	// SecretKeyRef selects the Secret key that holds the proxy URL.
+	SecretKeyRef corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
+// CABundleSecretReference refers to a Secret key holding a PEM-encoded CA bundle that is mounted
+// into the backup-restore sidecar.
+type CABundleSecretReference struct {
+	// Name is the name of the Secret containing the CA bundle.
+	Name string `json:"name"`
+	// Key is the key within the Secret under which the CA bundle is stored.
+	// Defaults to "bundle.crt" if not set.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
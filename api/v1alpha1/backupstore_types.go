@@ -0,0 +1,59 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// BackupStore holds the configuration for the object store that the backup-restore sidecar uses to
+// store and retrieve etcd backups.
+type BackupStore struct {
+	// Provider indicates the infrastructure provider for the object store.
+	// +optional
+	Provider *string `json:"provider,omitempty"`
+	// Container is the name of the bucket/container in which backups are stored.
+	// +optional
+	Container *string `json:"container,omitempty"`
+	// SecretRef refers to the Secret holding the credentials needed to access the object store.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+	// Proxy holds the optional HTTP(S) egress proxy configuration used by the backup-restore sidecar
+	// to reach the configured object store. It is never applied to the etcd container itself.
+	// +optional
+	Proxy *BackupStoreProxy `json:"proxy,omitempty"`
+	// Endpoint enables an S3-compatible endpoint override for the object store. This is a
+	// presence-only toggle, not the literal endpoint value: when set, the actual endpoint is read
+	// from the "endpoint" key of SecretRef, never from this field, so the value can be rotated by
+	// updating the Secret alone. Only honoured together with Region; see Region and
+	// getS3EndpointRegionEnvVars.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+	// Region enables an S3-compatible region override for the object store, read from the "region"
+	// key of SecretRef for the same reason described on Endpoint. Only honoured together with
+	// Endpoint.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// S3ForcePathStyle enables path-style addressing for an S3-compatible object store. Like
+	// Endpoint and Region, this is a presence-only toggle: the actual value is read from the
+	// "s3ForcePathStyle" key of SecretRef, never from this field.
+	// +optional
+	S3ForcePathStyle *bool `json:"s3ForcePathStyle,omitempty"`
+	// CABundleSecretRef refers to a Secret key holding a PEM-encoded CA bundle for a self-signed
+	// S3-compatible endpoint. When set, the bundle is mounted into the backup-restore sidecar and
+	// AWS_CA_BUNDLE is pointed at it.
+	// +optional
+	CABundleSecretRef *CABundleSecretReference `json:"caBundleSecretRef,omitempty"`
+}
@@ -64,6 +64,10 @@ func (c *component) Get(ctx context.Context) (*appsv1.StatefulSet, error) {
 }
 
 func (c *component) Deploy(ctx context.Context) error {
+	if err := c.validateBackupStore(ctx); err != nil {
+		return err
+	}
+
 	sts, err := c.Get(ctx)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -87,6 +91,38 @@ func (c *component) Deploy(ctx context.Context) error {
 	return c.syncStatefulset(ctx, sts)
 }
 
+// validateBackupStore rejects a half-configured S3 endpoint/region override before it reaches the
+// backup-restore sidecar: when Endpoint or Region is set, the referenced Secret must contain both
+// the "endpoint" and "region" keys, or neither. getS3EndpointRegionEnvVars only looks at the
+// Endpoint/Region pointers, so without this check the sidecar's env vars could reference a Secret
+// key that does not exist.
+func (c *component) validateBackupStore(ctx context.Context) error {
+	storeValues := c.values.BackupStore
+	if storeValues == nil || storeValues.SecretRef == nil {
+		return nil
+	}
+	if storeValues.Endpoint == nil && storeValues.Region == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: storeValues.SecretRef.Namespace, Name: storeValues.SecretRef.Name}
+	if secretKey.Namespace == "" {
+		secretKey.Namespace = c.values.Namespace
+	}
+	if err := c.client.Get(ctx, secretKey, secret); err != nil {
+		return fmt.Errorf("failed to fetch backup store secret %q for endpoint/region validation: %w", secretKey.Name, err)
+	}
+
+	_, hasEndpoint := secret.Data["endpoint"]
+	_, hasRegion := secret.Data["region"]
+	if hasEndpoint != hasRegion {
+		return fmt.Errorf("secret %q must contain both the %q and %q keys, or neither, but has endpoint=%t region=%t", secretKey.Name, "endpoint", "region", hasEndpoint, hasRegion)
+	}
+
+	return nil
+}
+
 func (c *component) Destroy(ctx context.Context) error {
 	sts := c.emptyStatefulset()
 
@@ -108,6 +144,10 @@ const (
 	defaultInterval = 5 * time.Second
 	// defaultTimeout is the default timeout for retry operations.
 	defaultTimeout = 90 * time.Second
+	// proxyCABundleMountPath is the path at which the CA bundle for the backup-restore egress proxy is mounted.
+	proxyCABundleMountPath = "/var/etcd/ssl/proxy/ca"
+	// s3CABundleMountPath is the path at which the CA bundle for a self-signed S3-compatible endpoint is mounted.
+	s3CABundleMountPath = "/var/etcd/ssl/s3/ca"
 )
 
 func (c *component) Wait(ctx context.Context) error {
@@ -491,6 +531,20 @@ func getBackupRestoreVolumeMounts(val Values) []corev1.VolumeMount {
 		})
 	}
 
+	if val.BackupStore.Proxy != nil && val.BackupStore.Proxy.CABundleSecretRef != nil {
+		vms = append(vms, corev1.VolumeMount{
+			Name:      "backup-restore-proxy-ca",
+			MountPath: proxyCABundleMountPath,
+		})
+	}
+
+	if provider == utils.S3 && val.BackupStore.CABundleSecretRef != nil {
+		vms = append(vms, corev1.VolumeMount{
+			Name:      "backup-restore-s3-ca",
+			MountPath: s3CABundleMountPath,
+		})
+	}
+
 	return vms
 }
 
@@ -624,6 +678,30 @@ func getVolumes(val Values) []corev1.Volume {
 		})
 	}
 
+	if storeValues.Proxy != nil && storeValues.Proxy.CABundleSecretRef != nil {
+		vs = append(vs, corev1.Volume{
+			Name: "backup-restore-proxy-ca",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: storeValues.Proxy.CABundleSecretRef.Name,
+					Items:      caBundleVolumeItems(storeValues.Proxy.CABundleSecretRef),
+				},
+			},
+		})
+	}
+
+	if provider == utils.S3 && storeValues.CABundleSecretRef != nil {
+		vs = append(vs, corev1.Volume{
+			Name: "backup-restore-s3-ca",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: storeValues.CABundleSecretRef.Name,
+					Items:      caBundleVolumeItems(storeValues.CABundleSecretRef),
+				},
+			},
+		})
+	}
+
 	return vs
 }
 
@@ -652,11 +730,26 @@ func getBackupRestoreEnvVars(val Values) []corev1.EnvVar {
 		return env
 	}
 
+	// Proxy env vars are independent of the object store provider, but are only added once the
+	// provider itself has been validated so that this stays in lockstep with the CA bundle volume
+	// added by getBackupRestoreVolumeMounts/getVolumes above (both bail out on the same error).
+	env = append(env, getProxyEnvVars(val)...)
+
 	// TODO(timuthy): move this to a non root path when we switch to a rootless distribution
 	const credentialsMountPath = "/root/etcd-backup"
 	switch provider {
 	case utils.S3:
 		env = append(env, getEnvVarFromValue("AWS_APPLICATION_CREDENTIALS", credentialsMountPath))
+		env = append(env, getS3EndpointRegionEnvVars(storeValues)...)
+		// S3ForcePathStyle is, like Endpoint and Region above, a presence-only toggle on BackupStore:
+		// its actual value always lives in the credentials Secret under "s3ForcePathStyle", never on
+		// the Etcd resource itself, so the same Secret can be rotated or re-pointed without a spec change.
+		if storeValues.S3ForcePathStyle != nil {
+			env = append(env, getEnvVarFromSecrets("AWS_S3_FORCE_PATH_STYLE", storeValues.SecretRef.Name, "s3ForcePathStyle"))
+		}
+		if storeValues.CABundleSecretRef != nil {
+			env = append(env, getEnvVarFromValue("AWS_CA_BUNDLE", s3CABundleMountPath+"/"+caBundleFileName))
+		}
 
 	case utils.ABS:
 		env = append(env, getEnvVarFromValue("AZURE_APPLICATION_CREDENTIALS", credentialsMountPath))
@@ -682,6 +775,106 @@ func getBackupRestoreEnvVars(val Values) []corev1.EnvVar {
 	return env
 }
 
+// getProxyEnvVars builds the HTTP(S)_PROXY/NO_PROXY/SSL_CERT_FILE env vars for the backup-restore
+// sidecar. These must never be set on the etcd container itself, so callers must only use this
+// for the backup-restore container's env vars.
+func getProxyEnvVars(val Values) []corev1.EnvVar {
+	proxy := val.BackupStore.Proxy
+	if proxy == nil {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+
+	if proxy.HTTPProxy != nil {
+		env = append(env, getEnvVarFromValueOrSecret("HTTP_PROXY", proxy.HTTPProxy))
+	}
+	if proxy.HTTPSProxy != nil {
+		env = append(env, getEnvVarFromValueOrSecret("HTTPS_PROXY", proxy.HTTPSProxy))
+	}
+	if proxy.HTTPProxy != nil || proxy.HTTPSProxy != nil {
+		// buildNoProxy unconditionally merges the in-cluster peer/client service DNS names into
+		// whatever the operator configured, so NO_PROXY can never end up routing peer traffic off
+		// cluster - there is no admission-time check this depends on, the guarantee lives here.
+		env = append(env, getEnvVarFromValue("NO_PROXY", buildNoProxy(val, proxy.NoProxy)))
+	}
+	if proxy.CABundleSecretRef != nil {
+		env = append(env, getEnvVarFromValue("SSL_CERT_FILE", proxyCABundleMountPath+"/"+caBundleFileName))
+	}
+
+	return env
+}
+
+// requiredNoProxyHosts returns the in-cluster peer and client service DNS names that must never be
+// routed through the backup-restore egress proxy.
+func requiredNoProxyHosts(val Values) []string {
+	clientServiceName := fmt.Sprintf("%s-client", val.Name)
+	return []string{
+		val.ServiceName,
+		fmt.Sprintf("%s.%s.svc", val.ServiceName, val.Namespace),
+		clientServiceName,
+		fmt.Sprintf("%s.%s.svc", clientServiceName, val.Namespace),
+	}
+}
+
+// buildNoProxy merges the operator-supplied NoProxy value with the in-cluster peer/client service
+// DNS names that must always bypass the proxy, so that intra-cluster peer traffic is never
+// unintentionally proxied regardless of what the operator configured.
+func buildNoProxy(val Values, operatorNoProxy *string) string {
+	entries := requiredNoProxyHosts(val)
+
+	if operatorNoProxy != nil {
+		for _, entry := range strings.Split(*operatorNoProxy, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry != "" {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	return strings.Join(dedupeStrings(entries), ",")
+}
+
+// dedupeStrings returns entries with duplicates removed, preserving the order of first occurrence.
+func dedupeStrings(entries []string) []string {
+	seen := make(map[string]struct{}, len(entries))
+	deduped := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if _, ok := seen[entry]; ok {
+			continue
+		}
+		seen[entry] = struct{}{}
+		deduped = append(deduped, entry)
+	}
+	return deduped
+}
+
+// caBundleFileName is the file name under which a CA bundle Secret key is always projected,
+// regardless of the key name used in the referenced Secret.
+const caBundleFileName = "bundle.crt"
+
+// caBundleVolumeItems projects the CA bundle Secret's key to the stable caBundleFileName path, so
+// a Secret using a non-default key name (e.g. "ca.crt", "tls.crt") does not silently result in a
+// missing-file error in the backup-restore sidecar.
+func caBundleVolumeItems(ref *druidv1alpha1.CABundleSecretReference) []corev1.KeyToPath {
+	return []corev1.KeyToPath{
+		{
+			Key:  pointer.StringDeref(ref.Key, caBundleFileName),
+			Path: caBundleFileName,
+		},
+	}
+}
+
+// getEnvVarFromValueOrSecret renders a proxy URL field that can either be a plain string value or
+// a reference to a key in the backup credentials Secret, so proxy credentials embedded in the URL
+// never need to be stored directly on the Etcd resource.
+func getEnvVarFromValueOrSecret(name string, source *druidv1alpha1.ProxyURL) corev1.EnvVar {
+	if source.ValueFrom != nil {
+		return getEnvVarFromSecrets(name, source.ValueFrom.SecretKeyRef.Name, source.ValueFrom.SecretKeyRef.Key)
+	}
+	return getEnvVarFromValue(name, pointer.StringDeref(source.Value, ""))
+}
+
 func getEnvVarFromValue(name, value string) corev1.EnvVar {
 	return corev1.EnvVar{
 		Name:  name,
@@ -712,4 +905,21 @@ func getEnvVarFromSecrets(name, secretName, secretKey string) corev1.EnvVar {
 			},
 		},
 	}
-}
\ No newline at end of file
+}
+
+// getS3EndpointRegionEnvVars returns the AWS_ENDPOINT_URL_S3/AWS_REGION env vars for an
+// S3-compatible store, sourced from the "endpoint"/"region" keys in the credentials Secret.
+// Endpoint and Region are presence-only toggles on BackupStore, not the literal values: the actual
+// strings always live in the Secret, never on the Etcd resource itself. They are only ever
+// honoured as a pair - validateBackupStore checks at Deploy time that the referenced Secret
+// actually contains both the "endpoint" and "region" keys, or neither, and this function
+// defensively re-checks the CR-level pointers so it stays safe even if ever called outside Deploy.
+func getS3EndpointRegionEnvVars(storeValues *druidv1alpha1.BackupStore) []corev1.EnvVar {
+	if storeValues.Endpoint == nil || storeValues.Region == nil {
+		return nil
+	}
+	return []corev1.EnvVar{
+		getEnvVarFromSecrets("AWS_ENDPOINT_URL_S3", storeValues.SecretRef.Name, "endpoint"),
+		getEnvVarFromSecrets("AWS_REGION", storeValues.SecretRef.Name, "region"),
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetS3EndpointRegionEnvVarsRequiresBothOrNeither(t *testing.T) {
+	endpoint, region := "endpoint", "region"
+	secretRef := corev1.SecretReference{Name: "s3-credentials"}
+
+	cases := []struct {
+		name     string
+		store    *druidv1alpha1.BackupStore
+		wantVars bool
+	}{
+		{"neither set", &druidv1alpha1.BackupStore{SecretRef: &secretRef}, false},
+		{"only endpoint set", &druidv1alpha1.BackupStore{SecretRef: &secretRef, Endpoint: &endpoint}, false},
+		{"only region set", &druidv1alpha1.BackupStore{SecretRef: &secretRef, Region: &region}, false},
+		{"both set", &druidv1alpha1.BackupStore{SecretRef: &secretRef, Endpoint: &endpoint, Region: &region}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := getS3EndpointRegionEnvVars(c.store)
+			if got := len(env) > 0; got != c.wantVars {
+				t.Fatalf("getS3EndpointRegionEnvVars() = %+v, wantVars=%v", env, c.wantVars)
+			}
+			if c.wantVars && (!hasEnvVar(env, "AWS_ENDPOINT_URL_S3") || !hasEnvVar(env, "AWS_REGION")) {
+				t.Fatalf("getS3EndpointRegionEnvVars() = %+v, want both AWS_ENDPOINT_URL_S3 and AWS_REGION", env)
+			}
+		})
+	}
+}
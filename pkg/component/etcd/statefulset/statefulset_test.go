@@ -0,0 +1,207 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+func testValues(proxy *druidv1alpha1.BackupStoreProxy) Values {
+	return Values{
+		Name:        "etcd-main",
+		Namespace:   "shoot--foo--bar",
+		ServiceName: "etcd-main-peer",
+		BackupStore: &druidv1alpha1.BackupStore{
+			Proxy: proxy,
+		},
+	}
+}
+
+func TestBuildNoProxyAlwaysIncludesInClusterServiceDNS(t *testing.T) {
+	val := testValues(nil)
+
+	noProxy := buildNoProxy(val, nil)
+
+	for _, want := range requiredNoProxyHosts(val) {
+		if !contains(splitCSV(noProxy), want) {
+			t.Errorf("buildNoProxy(%q) = %q, want it to contain required host %q", "nil", noProxy, want)
+		}
+	}
+}
+
+func TestBuildNoProxyMergesOperatorValueWithRequiredHosts(t *testing.T) {
+	val := testValues(nil)
+	operatorNoProxy := "169.254.169.254,metadata.google.internal"
+
+	noProxy := splitCSV(buildNoProxy(val, &operatorNoProxy))
+
+	for _, want := range append(requiredNoProxyHosts(val), "169.254.169.254", "metadata.google.internal") {
+		if !contains(noProxy, want) {
+			t.Errorf("buildNoProxy() = %v, missing expected entry %q", noProxy, want)
+		}
+	}
+}
+
+func TestBuildNoProxyKeepsRequiredHostsWhenOperatorOmitsThem(t *testing.T) {
+	val := testValues(nil)
+	// An operator cannot widen the proxy's reach to in-cluster peer traffic by omitting it: the
+	// required hosts are always merged in regardless of what they pass.
+	operatorNoProxy := "example.com"
+
+	noProxy := splitCSV(buildNoProxy(val, &operatorNoProxy))
+
+	for _, want := range requiredNoProxyHosts(val) {
+		if !contains(noProxy, want) {
+			t.Errorf("buildNoProxy() = %v, required host %q must always be present", noProxy, want)
+		}
+	}
+}
+
+func TestDedupeStringsPreservesFirstOccurrenceOrder(t *testing.T) {
+	got := dedupeStrings([]string{"a", "b", "a", "c", "b"})
+	want := []string{"a", "b", "c"}
+
+	if len(got) != len(want) {
+		t.Fatalf("dedupeStrings() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("dedupeStrings() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestGetEnvVarFromValueOrSecretPrefersPlainValue(t *testing.T) {
+	value := "http://proxy.example.com:3128"
+	env := getEnvVarFromValueOrSecret("HTTP_PROXY", &druidv1alpha1.ProxyURL{Value: &value})
+
+	if env.Name != "HTTP_PROXY" || env.Value != value || env.ValueFrom != nil {
+		t.Fatalf("getEnvVarFromValueOrSecret() = %+v, want plain value %q", env, value)
+	}
+}
+
+func TestGetEnvVarFromValueOrSecretSourcesFromSecret(t *testing.T) {
+	env := getEnvVarFromValueOrSecret("HTTPS_PROXY", &druidv1alpha1.ProxyURL{
+		ValueFrom: &druidv1alpha1.ProxyURLSource{
+			SecretKeyRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-credentials"},
+				Key:                  "httpsProxyURL",
+			},
+		},
+	})
+
+	if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+		t.Fatalf("getEnvVarFromValueOrSecret() = %+v, want a SecretKeyRef", env)
+	}
+	if env.ValueFrom.SecretKeyRef.Name != "proxy-credentials" || env.ValueFrom.SecretKeyRef.Key != "httpsProxyURL" {
+		t.Fatalf("getEnvVarFromValueOrSecret() = %+v, want secret %q key %q", env, "proxy-credentials", "httpsProxyURL")
+	}
+}
+
+func TestGetProxyEnvVarsNoProxyConfigured(t *testing.T) {
+	if env := getProxyEnvVars(testValues(nil)); env != nil {
+		t.Fatalf("getProxyEnvVars() = %+v, want nil when no Proxy is configured", env)
+	}
+}
+
+func TestGetProxyEnvVarsSetsNoProxyWheneverAProxyIsConfigured(t *testing.T) {
+	httpProxyValue := "http://proxy.example.com:3128"
+	val := testValues(&druidv1alpha1.BackupStoreProxy{
+		HTTPProxy: &druidv1alpha1.ProxyURL{Value: &httpProxyValue},
+	})
+
+	env := getProxyEnvVars(val)
+
+	if !hasEnvVar(env, "NO_PROXY") {
+		t.Fatalf("getProxyEnvVars() = %+v, want NO_PROXY to always be set once a proxy is configured", env)
+	}
+}
+
+func TestGetProxyEnvVarsSetsSSLCertFileWhenCABundleConfigured(t *testing.T) {
+	httpProxyValue := "http://proxy.example.com:3128"
+	val := testValues(&druidv1alpha1.BackupStoreProxy{
+		HTTPProxy:         &druidv1alpha1.ProxyURL{Value: &httpProxyValue},
+		CABundleSecretRef: &druidv1alpha1.CABundleSecretReference{Name: "proxy-ca", Key: pointer.String("ca.crt")},
+	})
+
+	env := getProxyEnvVars(val)
+
+	want := proxyCABundleMountPath + "/" + caBundleFileName
+	if got := envVarValue(env, "SSL_CERT_FILE"); got != want {
+		t.Fatalf("SSL_CERT_FILE = %q, want %q", got, want)
+	}
+}
+
+func TestCABundleVolumeItemsProjectsArbitraryKeyToStableFileName(t *testing.T) {
+	items := caBundleVolumeItems(&druidv1alpha1.CABundleSecretReference{Name: "s3-ca", Key: pointer.String("ca.crt")})
+
+	if len(items) != 1 || items[0].Key != "ca.crt" || items[0].Path != caBundleFileName {
+		t.Fatalf("caBundleVolumeItems() = %+v, want key %q projected to %q", items, "ca.crt", caBundleFileName)
+	}
+}
+
+func TestCABundleVolumeItemsDefaultsKeyWhenUnset(t *testing.T) {
+	items := caBundleVolumeItems(&druidv1alpha1.CABundleSecretReference{Name: "s3-ca"})
+
+	if len(items) != 1 || items[0].Key != caBundleFileName {
+		t.Fatalf("caBundleVolumeItems() = %+v, want default key %q", items, caBundleFileName)
+	}
+}
+
+func hasEnvVar(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func envVarValue(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+func splitCSV(s string) []string {
+	var out []string
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == ',' {
+			if i > start {
+				out = append(out, s[start:i])
+			}
+			start = i + 1
+		}
+	}
+	return out
+}
+
+func contains(entries []string, want string) bool {
+	for _, e := range entries {
+		if e == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"context"
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newValidateBackupStoreComponent(t *testing.T, store *druidv1alpha1.BackupStore, secret *corev1.Secret) *component {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	if secret != nil {
+		builder = builder.WithObjects(secret)
+	}
+
+	val := testValues(nil)
+	val.BackupStore = store
+
+	return &component{
+		client: builder.Build(),
+		values: val,
+	}
+}
+
+func TestValidateBackupStoreSkipsWhenEndpointAndRegionUnset(t *testing.T) {
+	secretRef := corev1.SecretReference{Name: "s3-credentials", Namespace: "shoot--foo--bar"}
+	c := newValidateBackupStoreComponent(t, &druidv1alpha1.BackupStore{SecretRef: &secretRef}, nil)
+
+	if err := c.validateBackupStore(context.Background()); err != nil {
+		t.Fatalf("validateBackupStore() = %v, want nil when neither Endpoint nor Region is set", err)
+	}
+}
+
+func TestValidateBackupStoreRejectsHalfConfiguredSecret(t *testing.T) {
+	endpoint := "https://s3.example.com"
+	secretRef := corev1.SecretReference{Name: "s3-credentials", Namespace: "shoot--foo--bar"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretRef.Name, Namespace: secretRef.Namespace},
+		Data:       map[string][]byte{"endpoint": []byte(endpoint)},
+	}
+	c := newValidateBackupStoreComponent(t, &druidv1alpha1.BackupStore{SecretRef: &secretRef, Endpoint: &endpoint}, secret)
+
+	if err := c.validateBackupStore(context.Background()); err == nil {
+		t.Fatal("validateBackupStore() = nil, want an error when the secret has endpoint but not region")
+	}
+}
+
+func TestValidateBackupStoreAcceptsBothKeysPresent(t *testing.T) {
+	endpoint, region := "https://s3.example.com", "eu-west-1"
+	secretRef := corev1.SecretReference{Name: "s3-credentials", Namespace: "shoot--foo--bar"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretRef.Name, Namespace: secretRef.Namespace},
+		Data:       map[string][]byte{"endpoint": []byte(endpoint), "region": []byte(region)},
+	}
+	c := newValidateBackupStoreComponent(t, &druidv1alpha1.BackupStore{SecretRef: &secretRef, Endpoint: &endpoint, Region: &region}, secret)
+
+	if err := c.validateBackupStore(context.Background()); err != nil {
+		t.Fatalf("validateBackupStore() = %v, want nil when both endpoint and region are present", err)
+	}
+}
+
+func TestValidateBackupStoreFailsWhenSecretMissing(t *testing.T) {
+	endpoint, region := "https://s3.example.com", "eu-west-1"
+	secretRef := corev1.SecretReference{Name: "s3-credentials", Namespace: "shoot--foo--bar"}
+	c := newValidateBackupStoreComponent(t, &druidv1alpha1.BackupStore{SecretRef: &secretRef, Endpoint: &endpoint, Region: &region}, nil)
+
+	if err := c.validateBackupStore(context.Background()); err == nil {
+		t.Fatal("validateBackupStore() = nil, want an error when the referenced secret does not exist")
+	}
+}